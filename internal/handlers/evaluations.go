@@ -3,13 +3,23 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/eval-hub/eval-hub/internal/executioncontext"
+	"github.com/eval-hub/eval-hub/internal/runtimes/k8s"
 	"github.com/eval-hub/eval-hub/internal/serialization"
 	"github.com/eval-hub/eval-hub/pkg/api"
+	evalhubv1alpha1 "github.com/eval-hub/eval-hub/pkg/apis/evalhub/v1alpha1"
 )
 
+// evaluationNamespace is the namespace EvaluationJob custom resources are tracked in.
+const evaluationNamespace = "default"
+
 // BackendSpec represents the backend specification
 type BackendSpec struct {
 	URL  string `json:"url"`
@@ -47,6 +57,27 @@ func (h *Handlers) HandleCreateEvaluation(ctx *executioncontext.ExecutionContext
 		return
 	}
 
+	// Persist an EvaluationJob CR alongside the Storage record, so the job is observable
+	// to kubectl/GitOps tools and gets reconciled by the EvaluationJobReconciler. h.jobs is
+	// nil when running without a Kubernetes control plane (e.g. LocalMode).
+	if h.jobs != nil {
+		cr := &evalhubv1alpha1.EvaluationJob{
+			ObjectMeta: metav1.ObjectMeta{Name: response.ID, Namespace: evaluationNamespace},
+			Spec: evalhubv1alpha1.EvaluationJobSpec{
+				ProviderID:       evaluation.ProviderID,
+				BenchmarkID:      evaluation.BenchmarkID,
+				Config:           evaluation.Config,
+				Image:            evaluation.Image,
+				Args:             evaluation.Args,
+				StorageSecretRef: evaluation.StorageSecretRef,
+			},
+		}
+		if _, err := h.jobs.Create(ctx, cr); err != nil {
+			h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	h.successResponse(ctx, w, response, http.StatusAccepted)
 }
 
@@ -56,10 +87,22 @@ func (h *Handlers) HandleListEvaluations(ctx *executioncontext.ExecutionContext,
 		return
 	}
 
+	items := []interface{}{}
+	if h.jobs != nil {
+		jobs, err := h.jobs.List()
+		if err != nil {
+			h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, job := range jobs {
+			items = append(items, job)
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"items":       []interface{}{},
-		"total_count": 0,
+		"items":       items,
+		"total_count": len(items),
 		"limit":       50,
 		"first":       map[string]string{"href": ""},
 		"next":        nil,
@@ -72,15 +115,34 @@ func (h *Handlers) HandleGetEvaluation(ctx *executioncontext.ExecutionContext, w
 		return
 	}
 
-	// Extract ID from path
-	pathParts := strings.Split(ctx.Request.URI(), "/")
-	id := pathParts[len(pathParts)-1]
+	id := jobIDFromPath(ctx, 0)
+
+	if h.jobs == nil {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message": "Evaluation retrieval not yet implemented",
+			"id":      id,
+		})
+		return
+	}
+
+	job, err := h.jobs.Get(evaluationNamespace, id)
+	if err != nil {
+		h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if job == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"message": "Evaluation not found",
+			"id":      id,
+		})
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"message": "Evaluation retrieval not yet implemented",
-		"id":      id,
-	})
+	json.NewEncoder(w).Encode(job)
 }
 
 // HandleCancelEvaluation handles DELETE /api/v1/evaluations/jobs/{id}
@@ -89,9 +151,17 @@ func (h *Handlers) HandleCancelEvaluation(ctx *executioncontext.ExecutionContext
 		return
 	}
 
-	// Extract ID from path
-	pathParts := strings.Split(ctx.Request.URI(), "/")
-	id := pathParts[len(pathParts)-1]
+	id := jobIDFromPath(ctx, 0)
+
+	// Tear down the cluster-side Job (and its pods) before forgetting the evaluation, so
+	// cancelling actually stops work instead of just dropping our record of it. h.kube is
+	// nil in LocalMode, where there is no cluster-side Job to begin with.
+	if h.kube != nil {
+		if err := h.kube.CancelJob(ctx, evaluationNamespace, id); err != nil {
+			h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
 
 	err := h.storage.DeleteEvaluationJob(ctx, id, true)
 	if err != nil {
@@ -108,8 +178,195 @@ func (h *Handlers) HandleGetEvaluationSummary(ctx *executioncontext.ExecutionCon
 		return
 	}
 
+	id := jobIDFromPath(ctx, 1)
+
+	healthchecks, err := h.evaluationHealthchecks(id)
+	if err != nil {
+		h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"message": "Evaluation summary not yet implemented",
+		"message":      "Evaluation summary not yet implemented",
+		"healthchecks": healthchecks,
+	})
+}
+
+// HandleGetEvaluationHealthchecks handles GET /api/v1/evaluations/jobs/{id}/healthchecks
+func (h *Handlers) HandleGetEvaluationHealthchecks(ctx *executioncontext.ExecutionContext, w http.ResponseWriter) {
+	if !h.checkMethod(ctx, http.MethodGet, w) {
+		return
+	}
+
+	id := jobIDFromPath(ctx, 1)
+
+	healthchecks, err := h.evaluationHealthchecks(id)
+	if err != nil {
+		h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.successResponse(ctx, w, healthchecks, http.StatusOK)
+}
+
+// evaluationHealthchecks returns the healthcheck results RunEvaluationJob recorded on the
+// EvaluationJob CR's status for id. It returns an empty result, not an error, when there is
+// no Kubernetes control plane configured (h.jobs is nil, e.g. LocalMode) or the CR doesn't
+// exist (yet).
+func (h *Handlers) evaluationHealthchecks(id string) ([]evalhubv1alpha1.HealthcheckResult, error) {
+	if h.jobs == nil {
+		return nil, nil
+	}
+	job, err := h.jobs.Get(evaluationNamespace, id)
+	if err != nil || job == nil {
+		return nil, err
+	}
+	return job.Status.Healthchecks, nil
+}
+
+// jobIDFromPath extracts the evaluation job ID from a request URI, where fromEnd counts
+// back from the final path segment (1 skips one trailing segment, e.g. "/summary").
+func jobIDFromPath(ctx *executioncontext.ExecutionContext, fromEnd int) string {
+	pathParts := strings.Split(ctx.Request.URI(), "/")
+	return pathParts[len(pathParts)-1-fromEnd]
+}
+
+// HandleGetEvaluationLogs handles GET /api/v1/evaluations/jobs/{id}/logs. It multiplexes
+// every container's logs across every pod of the job as newline-delimited JSON records
+// over a chunked response, following new lines until the client disconnects or every
+// container's log stream has ended. In LocalMode (h.kube is nil), where there is no cluster
+// to stream pod logs from, it falls back to tailLocalProcessLogs.
+func (h *Handlers) HandleGetEvaluationLogs(ctx *executioncontext.ExecutionContext, w http.ResponseWriter) {
+	if !h.checkMethod(ctx, http.MethodGet, w) {
+		return
+	}
+	id := jobIDFromPath(ctx, 1)
+
+	if h.kube == nil {
+		h.tailLocalProcessLogs(ctx, id, w)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.errorResponse(ctx, w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	records := make(chan k8s.LogRecord)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.kube.StreamJobLogs(ctx, evaluationNamespace, id, resumeSinceTime(ctx), records)
+		close(records)
+	}()
+
+	encoder := json.NewEncoder(w)
+	for record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+	<-errCh
+}
+
+// HandleGetEvaluationEvents handles GET /api/v1/evaluations/jobs/{id}/events. It streams
+// corev1.Event objects involving the evaluation's pods as newline-delimited JSON records
+// until the client disconnects or the API server closes the event watch. It falls back the same way HandleGetEvaluationLogs does
+// when h.kube is nil.
+func (h *Handlers) HandleGetEvaluationEvents(ctx *executioncontext.ExecutionContext, w http.ResponseWriter) {
+	if !h.checkMethod(ctx, http.MethodGet, w) {
+		return
+	}
+	id := jobIDFromPath(ctx, 1)
+
+	if h.kube == nil {
+		h.tailLocalProcessLogs(ctx, id, w)
+		return
+	}
+
+	// Resolved from the pod selector rather than a Job lookup by JobGVK/JobName: an
+	// evaluation installed as a Helm chart (see chunk0-2) may not materialize a Job at all,
+	// or name it anything the chart chooses, so GetObject(JobGVK, JobName(id)) would 404 a
+	// perfectly healthy, actively-running evaluation. The pod label selector is the one
+	// thing every evaluation path (hand-built Job or chart) guarantees.
+	pods, err := h.kube.PodsForSelector(ctx, evaluationNamespace, k8s.JobSelector(id))
+	if err != nil {
+		h.errorResponse(ctx, w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(pods) == 0 {
+		h.errorResponse(ctx, w, "evaluation job not found", http.StatusNotFound)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.errorResponse(ctx, w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	resourceUIDs := make(map[types.UID]bool, len(pods))
+	for _, pod := range pods {
+		resourceUIDs[pod.UID] = true
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	records := make(chan k8s.EventRecord)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.kube.WatchJobEvents(ctx, evaluationNamespace, resourceUIDs, resumeSinceTime(ctx), records)
+		close(records)
+	}()
+
+	encoder := json.NewEncoder(w)
+	for record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+	<-errCh
+}
+
+// tailLocalProcessLogs is the LocalMode fallback for both the logs and events endpoints:
+// without a cluster there are no pods or Kubernetes events to stream. It writes a single
+// record saying that streaming is not available in LocalMode and returns; it does not read
+// or tail any output of the evaluation's local process.
+func (h *Handlers) tailLocalProcessLogs(ctx *executioncontext.ExecutionContext, id string, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
+	encoder.Encode(map[string]interface{}{
+		"pod":       "local",
+		"container": "local",
+		"ts":        time.Now().UTC(),
+		"line":      "log/event streaming is not available in LocalMode for job " + id,
 	})
 }
+
+// resumeSinceTime reads the sinceTime/Last-Event-ID query parameters so a client that
+// dropped a streaming connection can resume without replaying everything it already saw.
+func resumeSinceTime(ctx *executioncontext.ExecutionContext) time.Time {
+	u, err := url.Parse(ctx.Request.URI())
+	if err != nil {
+		return time.Time{}
+	}
+
+	query := u.Query()
+	for _, key := range []string{"sinceTime", "Last-Event-ID"} {
+		if v := query.Get(key); v != "" {
+			if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				return t
+			}
+		}
+	}
+	return time.Time{}
+}
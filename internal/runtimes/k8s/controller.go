@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/eval-hub/eval-hub/internal/abstractions"
+	"github.com/eval-hub/eval-hub/internal/runtimes/k8s/healthcheck"
+	"github.com/eval-hub/eval-hub/pkg/api"
+	evalhubv1alpha1 "github.com/eval-hub/eval-hub/pkg/apis/evalhub/v1alpha1"
+)
+
+// reconcileRequeueInterval is how often Reconcile checks back on an EvaluationJob whose
+// run is dispatched to a background goroutine.
+const reconcileRequeueInterval = 5 * time.Second
+
+// EvaluationJobReconciler reconciles EvaluationJob custom resources into the concrete
+// Jobs/ConfigMaps RunEvaluationJob produces, and writes the observed outcome back onto the
+// CR's status so kubectl/GitOps tools see real progress instead of a stub.
+type EvaluationJobReconciler struct {
+	client.Client
+	runtime *K8sRuntime
+	storage *abstractions.Storage
+
+	mu      sync.Mutex
+	running map[types.NamespacedName]bool
+}
+
+// SetupEvaluationJobController registers an EvaluationJobReconciler with mgr, watching
+// EvaluationJob custom resources.
+func SetupEvaluationJobController(mgr manager.Manager, runtime *K8sRuntime, storage *abstractions.Storage) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&evalhubv1alpha1.EvaluationJob{}).
+		Complete(&EvaluationJobReconciler{
+			Client:  mgr.GetClient(),
+			runtime: runtime,
+			storage: storage,
+			running: make(map[types.NamespacedName]bool),
+		})
+}
+
+// Reconcile drives a single EvaluationJob towards completion. RunEvaluationJob can run for
+// as long as the evaluation takes, so a job not yet in a terminal phase is dispatched to a
+// background goroutine (at most once per job) rather than run inline, which would otherwise
+// block this controller's worker - and every other job waiting on it - for the duration of
+// the run. Reconcile itself only ever does quick Get/Status().Update calls and requeues
+// until the background run finishes and flips the phase to a terminal value.
+func (r *EvaluationJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	job := &evalhubv1alpha1.EvaluationJob{}
+	if err := r.Get(ctx, req.NamespacedName, job); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	switch job.Status.Phase {
+	case evalhubv1alpha1.EvaluationJobPhaseSucceeded, evalhubv1alpha1.EvaluationJobPhaseFailed:
+		return ctrl.Result{}, nil
+	case evalhubv1alpha1.EvaluationJobPhaseRunning:
+		return ctrl.Result{RequeueAfter: reconcileRequeueInterval}, nil
+	}
+
+	if !r.startRun(req.NamespacedName, job) {
+		return ctrl.Result{RequeueAfter: reconcileRequeueInterval}, nil
+	}
+
+	job.Status.Phase = evalhubv1alpha1.EvaluationJobPhaseRunning
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: reconcileRequeueInterval}, nil
+}
+
+// startRun launches RunEvaluationJob for job in the background, unless a run for the same
+// NamespacedName is already in flight. It returns whether it started a new run.
+func (r *EvaluationJobReconciler) startRun(key types.NamespacedName, job *evalhubv1alpha1.EvaluationJob) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.running[key] {
+		return false
+	}
+	r.running[key] = true
+
+	evaluation := &api.EvaluationJobResource{
+		ID:               job.Name,
+		Namespace:        job.Namespace,
+		ProviderID:       job.Spec.ProviderID,
+		BenchmarkID:      job.Spec.BenchmarkID,
+		Image:            job.Spec.Image,
+		Args:             job.Spec.Args,
+		StorageSecretRef: job.Spec.StorageSecretRef,
+	}
+	go r.run(key, evaluation)
+	return true
+}
+
+// run executes evaluation and writes the outcome back onto the CR's status once it
+// finishes. It re-fetches the CR instead of reusing the one Reconcile last saw, since the
+// run may take much longer than a single reconcile pass.
+func (r *EvaluationJobReconciler) run(key types.NamespacedName, evaluation *api.EvaluationJobResource) {
+	defer func() {
+		r.mu.Lock()
+		delete(r.running, key)
+		r.mu.Unlock()
+	}()
+
+	runErr := r.runtime.RunEvaluationJob(evaluation, r.storage)
+
+	ctx := context.Background()
+	job := &evalhubv1alpha1.EvaluationJob{}
+	if err := r.Get(ctx, key, job); err != nil {
+		return
+	}
+
+	job.Status.Phase = evalhubv1alpha1.EvaluationJobPhaseSucceeded
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "EvaluationCompleted",
+		LastTransitionTime: metav1.Now(),
+	}
+	if runErr != nil {
+		job.Status.Phase = evalhubv1alpha1.EvaluationJobPhaseFailed
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "EvaluationFailed"
+		condition.Message = runErr.Error()
+	}
+	job.Status.Conditions = append(job.Status.Conditions, condition)
+	job.Status.Healthchecks = convertHealthchecks(evaluation.Healthchecks)
+
+	_ = r.Status().Update(ctx, job)
+}
+
+// convertHealthchecks translates the healthcheck package's Result values (produced inside
+// RunEvaluationJob) into the CR's own HealthcheckResult type, so the k8s package's API
+// types don't leak into pkg/apis/evalhub/v1alpha1.
+func convertHealthchecks(results []healthcheck.Result) []evalhubv1alpha1.HealthcheckResult {
+	out := make([]evalhubv1alpha1.HealthcheckResult, 0, len(results))
+	for _, result := range results {
+		out = append(out, evalhubv1alpha1.HealthcheckResult{
+			Name:      result.Name,
+			Status:    result.Status,
+			StartedAt: metav1.NewTime(result.StartedAt),
+			EndedAt:   metav1.NewTime(result.EndedAt),
+			LogRef:    result.LogRef,
+		})
+	}
+	return out
+}
@@ -0,0 +1,35 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eval-hub/eval-hub/internal/runtimes/k8s/healthcheck"
+)
+
+func TestConvertHealthchecks(t *testing.T) {
+	started := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	ended := started.Add(time.Minute)
+
+	out := convertHealthchecks([]healthcheck.Result{
+		{Name: "smoke-test", Status: healthcheck.StatusSucceeded, StartedAt: started, EndedAt: ended, LogRef: "healthchecks/smoke-test.log"},
+	})
+
+	if len(out) != 1 {
+		t.Fatalf("len(out) = %d, want 1", len(out))
+	}
+	got := out[0]
+	if got.Name != "smoke-test" || got.Status != healthcheck.StatusSucceeded || got.LogRef != "healthchecks/smoke-test.log" {
+		t.Fatalf("unexpected conversion: %+v", got)
+	}
+	if !got.StartedAt.Time.Equal(started) || !got.EndedAt.Time.Equal(ended) {
+		t.Fatalf("timestamps not preserved: %+v", got)
+	}
+}
+
+func TestConvertHealthchecksEmpty(t *testing.T) {
+	out := convertHealthchecks(nil)
+	if len(out) != 0 {
+		t.Fatalf("len(out) = %d, want 0", len(out))
+	}
+}
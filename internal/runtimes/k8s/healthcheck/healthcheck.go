@@ -0,0 +1,234 @@
+// Package healthcheck runs post-install healthcheck hooks against the resources
+// RunEvaluationJob creates, before it waits on the workload; a failing hook fails the
+// evaluation. The hooks do not gate the EvaluationJob's Running phase, which the
+// controller sets when it dispatches the run. This mirrors Helm's own test-hook convention: hooks are
+// ordinary Pods/Jobs annotated with eval-hub.io/hook, executed and torn down by this
+// package rather than by Helm itself.
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/eval-hub/eval-hub/internal/abstractions"
+)
+
+// Helper is the subset of *k8s.KubernetesHelper a Runner needs. It is declared here
+// (rather than importing the k8s package's concrete type) so that package k8s can call
+// into healthcheck from RunEvaluationJob without an import cycle; *k8s.KubernetesHelper
+// satisfies it structurally.
+type Helper interface {
+	CreateObject(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	GetObject(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error)
+	DeleteResource(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string, propagation metav1.DeletionPropagation) error
+	PodsForSelector(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error)
+	PodLogs(ctx context.Context, namespace, pod, container string, follow bool) (io.ReadCloser, error)
+}
+
+// Hook annotations, modelled on Helm's helm.sh/hook ones but under the eval-hub.io prefix.
+// Objects carrying only Helm's own helm.sh/hook annotations are not discovered.
+const (
+	AnnotationHook         = "eval-hub.io/hook"
+	AnnotationDeletePolicy = "eval-hub.io/hook-delete-policy"
+
+	HookTypeTest = "test"
+
+	DeletePolicyHookSucceeded      = "hook-succeeded"
+	DeletePolicyHookFailed         = "hook-failed"
+	DeletePolicyBeforeHookCreation = "before-hook-creation"
+)
+
+// DefaultTimeout bounds how long a single hook is given to reach a terminal phase.
+const DefaultTimeout = 5 * time.Minute
+
+const pollInterval = 2 * time.Second
+
+// StatusSucceeded and StatusFailed are the terminal states recorded in a Result.
+const (
+	StatusSucceeded = "Succeeded"
+	StatusFailed    = "Failed"
+)
+
+// Result records the outcome of a single hook execution.
+type Result struct {
+	Name      string
+	Status    string
+	StartedAt time.Time
+	EndedAt   time.Time
+	LogRef    string
+}
+
+// Runner executes post-install healthcheck hooks and records their outcome.
+type Runner struct {
+	helper  Helper
+	timeout time.Duration
+}
+
+// NewRunner builds a Runner backed by helper. A zero or negative timeout defaults to
+// DefaultTimeout.
+func NewRunner(helper Helper, timeout time.Duration) *Runner {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Runner{helper: helper, timeout: timeout}
+}
+
+// Discover returns the subset of objs annotated as test hooks.
+func Discover(objs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	var hooks []*unstructured.Unstructured
+	for _, obj := range objs {
+		if obj.GetAnnotations()[AnnotationHook] == HookTypeTest {
+			hooks = append(hooks, obj)
+		}
+	}
+	return hooks
+}
+
+// Run instantiates each hook in namespace, waits for it to reach a terminal phase,
+// persists its Pod logs to storage, applies its delete policy, and returns one Result per
+// hook in the order given. It stops at the first hook that errors, still returning the
+// Results gathered so far.
+func (r *Runner) Run(ctx context.Context, namespace string, hooks []*unstructured.Unstructured, storage *abstractions.Storage) ([]Result, error) {
+	results := make([]Result, 0, len(hooks))
+	for _, hook := range hooks {
+		result, err := r.runOne(ctx, namespace, hook, storage)
+		results = append(results, result)
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+func (r *Runner) runOne(ctx context.Context, namespace string, hook *unstructured.Unstructured, storage *abstractions.Storage) (Result, error) {
+	name := hook.GetName()
+	result := Result{Name: name, StartedAt: time.Now()}
+
+	policy := hook.GetAnnotations()[AnnotationDeletePolicy]
+	if policy == DeletePolicyBeforeHookCreation {
+		if err := r.helper.DeleteResource(ctx, hook.GroupVersionKind(), namespace, name, metav1.DeletePropagationForeground); err != nil {
+			return result, fmt.Errorf("clearing previous hook %s: %w", name, err)
+		}
+	}
+
+	if hook.GetNamespace() == "" {
+		hook.SetNamespace(namespace)
+	}
+	if _, err := r.helper.CreateObject(ctx, hook); err != nil {
+		return result, fmt.Errorf("creating hook %s: %w", name, err)
+	}
+
+	status, waitErr := r.waitForHook(ctx, namespace, hook)
+	result.Status = status
+	result.EndedAt = time.Now()
+
+	// A log capture failure doesn't invalidate the hook's own pass/fail result.
+	if logRef, err := r.captureLogs(ctx, namespace, hook, storage); err == nil {
+		result.LogRef = logRef
+	}
+
+	if err := r.applyDeletePolicy(ctx, namespace, hook, policy, status); err != nil && waitErr == nil {
+		waitErr = err
+	}
+	return result, waitErr
+}
+
+// waitForHook polls the hook's pod(s) until the first pod listed reaches a terminal phase
+// or the Runner's timeout elapses; the phases of any other pods are not checked.
+func (r *Runner) waitForHook(ctx context.Context, namespace string, hook *unstructured.Unstructured) (string, error) {
+	deadline := time.Now().Add(r.timeout)
+	for {
+		pods, err := r.hookPods(ctx, namespace, hook)
+		if err != nil {
+			return StatusFailed, err
+		}
+		if len(pods) > 0 {
+			switch pods[0].Status.Phase {
+			case corev1.PodSucceeded:
+				return StatusSucceeded, nil
+			case corev1.PodFailed:
+				return StatusFailed, fmt.Errorf("hook %s failed", hook.GetName())
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return StatusFailed, fmt.Errorf("timed out waiting for hook %s", hook.GetName())
+		}
+		select {
+		case <-ctx.Done():
+			return StatusFailed, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// hookPods resolves the Pod(s) backing hook: itself if it is a Pod, or its owned Pods if
+// it is a Job.
+func (r *Runner) hookPods(ctx context.Context, namespace string, hook *unstructured.Unstructured) ([]corev1.Pod, error) {
+	if hook.GetKind() == "Pod" {
+		var pod corev1.Pod
+		obj, err := r.helper.GetObject(ctx, hook.GroupVersionKind(), namespace, hook.GetName())
+		if err != nil || obj == nil {
+			return nil, err
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod); err != nil {
+			return nil, err
+		}
+		return []corev1.Pod{pod}, nil
+	}
+	return r.helper.PodsForSelector(ctx, namespace, fmt.Sprintf("job-name=%s", hook.GetName()))
+}
+
+// captureLogs persists every container's logs for hook's pod(s) to storage and returns a
+// reference to the first one captured.
+func (r *Runner) captureLogs(ctx context.Context, namespace string, hook *unstructured.Unstructured, storage *abstractions.Storage) (string, error) {
+	pods, err := r.hookPods(ctx, namespace, hook)
+	if err != nil {
+		return "", err
+	}
+
+	var logRef string
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			key := fmt.Sprintf("healthchecks/%s/%s/%s.log", hook.GetName(), pod.Name, container.Name)
+			stream, err := r.helper.PodLogs(ctx, namespace, pod.Name, container.Name, false)
+			if err != nil {
+				return logRef, fmt.Errorf("opening logs for %s/%s: %w", pod.Name, container.Name, err)
+			}
+			data, err := io.ReadAll(stream)
+			stream.Close()
+			if err != nil {
+				return logRef, fmt.Errorf("reading logs for %s/%s: %w", pod.Name, container.Name, err)
+			}
+			if err := storage.Write(ctx, key, data); err != nil {
+				return logRef, fmt.Errorf("persisting logs for %s/%s: %w", pod.Name, container.Name, err)
+			}
+			if logRef == "" {
+				logRef = key
+			}
+		}
+	}
+	return logRef, nil
+}
+
+// applyDeletePolicy cleans up hook according to policy and the outcome it reached.
+// before-hook-creation is handled on the next run instead, by runOne.
+func (r *Runner) applyDeletePolicy(ctx context.Context, namespace string, hook *unstructured.Unstructured, policy, status string) error {
+	shouldDelete := (policy == DeletePolicyHookSucceeded && status == StatusSucceeded) ||
+		(policy == DeletePolicyHookFailed && status == StatusFailed)
+	if !shouldDelete {
+		return nil
+	}
+	if err := r.helper.DeleteResource(ctx, hook.GroupVersionKind(), namespace, hook.GetName(), metav1.DeletePropagationForeground); err != nil {
+		return fmt.Errorf("deleting hook %s after %s: %w", hook.GetName(), status, err)
+	}
+	return nil
+}
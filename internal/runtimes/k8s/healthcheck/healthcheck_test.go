@@ -0,0 +1,108 @@
+package healthcheck
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/eval-hub/eval-hub/internal/abstractions"
+)
+
+func TestDiscover(t *testing.T) {
+	hook := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	hook.SetAnnotations(map[string]string{AnnotationHook: HookTypeTest})
+	plain := &unstructured.Unstructured{Object: map[string]interface{}{}}
+
+	hooks := Discover([]*unstructured.Unstructured{hook, plain})
+	if len(hooks) != 1 || hooks[0] != hook {
+		t.Fatalf("Discover() = %v, want only the annotated hook", hooks)
+	}
+}
+
+func TestDiscoverNone(t *testing.T) {
+	plain := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	if hooks := Discover([]*unstructured.Unstructured{plain}); len(hooks) != 0 {
+		t.Fatalf("Discover() = %v, want none", hooks)
+	}
+}
+
+// fakeHelper is a minimal in-memory Helper for exercising Runner.Run without a cluster.
+type fakeHelper struct {
+	pod corev1.Pod
+}
+
+func (f *fakeHelper) CreateObject(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return obj, nil
+}
+
+func (f *fakeHelper) GetObject(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	return nil, nil
+}
+
+func (f *fakeHelper) DeleteResource(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string, propagation metav1.DeletionPropagation) error {
+	return nil
+}
+
+func (f *fakeHelper) PodsForSelector(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error) {
+	return []corev1.Pod{f.pod}, nil
+}
+
+func (f *fakeHelper) PodLogs(ctx context.Context, namespace, pod, container string, follow bool) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("ok\n")), nil
+}
+
+func TestRunnerRunSucceeds(t *testing.T) {
+	helper := &fakeHelper{pod: corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-test-pod"},
+		Status:     corev1.PodStatus{Phase: corev1.PodSucceeded},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "test"}}},
+	}}
+
+	hook := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	hook.SetKind("Job")
+	hook.SetName("smoke-test")
+	hook.SetAnnotations(map[string]string{AnnotationHook: HookTypeTest})
+
+	storage := &abstractions.Storage{}
+	runner := NewRunner(helper, 0)
+
+	results, err := runner.Run(context.Background(), "default", []*unstructured.Unstructured{hook}, storage)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(results) != 1 || results[0].Status != StatusSucceeded || results[0].Name != "smoke-test" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
+
+func TestRunnerRunFails(t *testing.T) {
+	helper := &fakeHelper{pod: corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-test-pod"},
+		Status:     corev1.PodStatus{Phase: corev1.PodFailed},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "test"}}},
+	}}
+
+	hook := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	hook.SetKind("Job")
+	hook.SetName("smoke-test")
+
+	storage := &abstractions.Storage{}
+	runner := NewRunner(helper, 0)
+
+	results, err := runner.Run(context.Background(), "default", []*unstructured.Unstructured{hook}, storage)
+	if err == nil {
+		t.Fatal("expected Run() to return an error for a failed hook")
+	}
+	if len(results) != 1 || results[0].Status != StatusFailed {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if !strings.Contains(err.Error(), "smoke-test") {
+		t.Fatalf("error %q should reference the failing hook", err)
+	}
+}
@@ -0,0 +1,207 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ChartSource identifies a Helm v3 chart to render, either a path on disk or an OCI
+// reference such as "oci://registry.example.com/charts/lm-eval". Version is only used
+// for OCI sources; local charts are always loaded as-is.
+type ChartSource struct {
+	Location string
+	Version  string
+}
+
+// HelmRenderer renders Helm v3 charts into Kubernetes resources without going through
+// Helm's own storage/release machinery, then applies them through the same dynamic
+// client + REST mapper path as CreateResourceFromFile. This lets evaluation providers be
+// shipped as third-party lm-eval/unitxt/trustyai charts instead of hand-written manifests.
+type HelmRenderer struct {
+	helper   *KubernetesHelper
+	settings *cli.EnvSettings
+}
+
+// NewHelmRenderer builds a HelmRenderer that applies rendered resources through helper.
+func NewHelmRenderer(helper *KubernetesHelper) *HelmRenderer {
+	return &HelmRenderer{helper: helper, settings: cli.New()}
+}
+
+// Render loads the chart, merges values over the chart's own defaults, and renders its
+// templates via Helm's dry-run install action. It returns the rendered manifests split
+// into individual objects, along with any hooks (pre-install, post-install, ...) the
+// chart declares.
+func (r *HelmRenderer) Render(
+	namespace, releaseName string,
+	source ChartSource,
+	values map[string]interface{},
+) ([]*unstructured.Unstructured, []*release.Hook, error) {
+	chrt, err := r.loadChart(source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading chart %s: %w", source.Location, err)
+	}
+
+	merged, err := chartutil.CoalesceValues(chrt, values)
+	if err != nil {
+		return nil, nil, fmt.Errorf("merging values for chart %s: %w", source.Location, err)
+	}
+
+	// Init populates actionConfig's Log func (among other fields); action.Install.Run logs
+	// through it unconditionally, so a zero-value *action.Configuration panics on first use.
+	// "memory" is enough for a dry-run, client-only install, which never persists a release.
+	actionConfig := new(action.Configuration)
+	if err := actionConfig.Init(r.settings.RESTClientGetter(), namespace, "memory", func(string, ...interface{}) {}); err != nil {
+		return nil, nil, fmt.Errorf("initializing helm action configuration: %w", err)
+	}
+
+	install := action.NewInstall(actionConfig)
+	install.DryRun = true
+	install.ClientOnly = true
+	install.IncludeCRDs = true
+	install.ReleaseName = releaseName
+	install.Namespace = namespace
+
+	rel, err := install.Run(chrt, merged)
+	if err != nil {
+		return nil, nil, fmt.Errorf("rendering chart %s: %w", source.Location, err)
+	}
+
+	objs, err := splitManifests(rel.Manifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing rendered manifests for chart %s: %w", source.Location, err)
+	}
+	return objs, rel.Hooks, nil
+}
+
+// InstallWithHooks renders source and applies its resources, running any pre-install
+// hooks beforehand and any post-install hooks afterward, in Helm's own hook-weight order.
+func (r *HelmRenderer) InstallWithHooks(
+	ctx context.Context,
+	namespace, releaseName string,
+	source ChartSource,
+	values map[string]interface{},
+) ([]*unstructured.Unstructured, error) {
+	objs, hooks, err := r.Render(namespace, releaseName, source, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runHooks(ctx, namespace, hooks, release.HookPreInstall); err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objs {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+	}
+	// Apply in dependency order (Namespaces/CRDs before the workloads that use them)
+	// instead of creating each object in the chart's own manifest order, and patch rather
+	// than fail when re-installing over an existing release.
+	if err := NewResourceApplier(r.helper).Apply(ctx, objs); err != nil {
+		return nil, fmt.Errorf("applying chart resources: %w", err)
+	}
+
+	if err := r.runHooks(ctx, namespace, hooks, release.HookPostInstall); err != nil {
+		return objs, err
+	}
+	return objs, nil
+}
+
+func (r *HelmRenderer) runHooks(ctx context.Context, namespace string, hooks []*release.Hook, event release.HookEvent) error {
+	matching := make([]*release.Hook, 0, len(hooks))
+	for _, hook := range hooks {
+		for _, hookEvent := range hook.Events {
+			if hookEvent == event {
+				matching = append(matching, hook)
+				break
+			}
+		}
+	}
+	sort.SliceStable(matching, func(i, j int) bool { return matching[i].Weight < matching[j].Weight })
+
+	for _, hook := range matching {
+		objs, err := splitManifests(hook.Manifest)
+		if err != nil {
+			return fmt.Errorf("parsing hook %s manifest: %w", hook.Name, err)
+		}
+		for _, obj := range objs {
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace(namespace)
+			}
+			if _, err := r.helper.CreateObject(ctx, obj); err != nil {
+				return fmt.Errorf("running hook %s: %w", hook.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// loadChart loads source from disk, or pulls it from an OCI registry into a temporary
+// directory first when source.Location starts with "oci://".
+func (r *HelmRenderer) loadChart(source ChartSource) (*chart.Chart, error) {
+	if !registry.IsOCI(source.Location) {
+		return loader.Load(source.Location)
+	}
+
+	dir, err := os.MkdirTemp("", "eval-hub-chart-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	regClient, err := registry.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("creating OCI registry client: %w", err)
+	}
+
+	pull := action.NewPullWithOpts(action.WithConfig(&action.Configuration{RegistryClient: regClient}))
+	pull.Settings = r.settings
+	pull.DestDir = dir
+	pull.Version = source.Version
+	if _, err := pull.Run(source.Location); err != nil {
+		return nil, fmt.Errorf("pulling chart from %s: %w", source.Location, err)
+	}
+
+	archives, err := filepath.Glob(filepath.Join(dir, "*.tgz"))
+	if err != nil || len(archives) == 0 {
+		return nil, fmt.Errorf("no chart archive pulled from %s", source.Location)
+	}
+	return loader.Load(archives[0])
+}
+
+// splitManifests splits a multi-document YAML string, as produced by a Helm render, into
+// individual unstructured objects.
+func splitManifests(manifest string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	decoder := apiyaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(obj); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
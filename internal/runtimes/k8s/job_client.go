@@ -0,0 +1,107 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	evalhubv1alpha1 "github.com/eval-hub/eval-hub/pkg/apis/evalhub/v1alpha1"
+)
+
+// evaluationJobResource is the GroupVersionResource the dynamic client and informer use
+// to address EvaluationJob custom resources.
+var evaluationJobResource = schema.GroupVersionResource{
+	Group:    evalhubv1alpha1.GroupName,
+	Version:  "v1alpha1",
+	Resource: "evaluationjobs",
+}
+
+// evaluationJobInformerResync bounds how stale the informer's cache is allowed to get
+// between full relists.
+const evaluationJobInformerResync = 30 * time.Second
+
+// EvaluationJobClient persists EvaluationJob custom resources through the dynamic client
+// and serves reads from a shared informer cache, so HandleListEvaluations and
+// HandleGetEvaluation don't hit the API server on every request.
+type EvaluationJobClient struct {
+	helper   *KubernetesHelper
+	informer cache.SharedIndexInformer
+}
+
+// NewEvaluationJobClient builds an EvaluationJobClient backed by helper, scoped to
+// namespace (empty string watches all namespaces), and blocks until its informer's
+// initial list has completed.
+func NewEvaluationJobClient(ctx context.Context, helper *KubernetesHelper, namespace string) (*EvaluationJobClient, error) {
+	informer := helper.InformerFor(evaluationJobResource, namespace, evaluationJobInformerResync)
+
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return nil, fmt.Errorf("timed out waiting for evaluationjob informer to sync")
+	}
+	return &EvaluationJobClient{helper: helper, informer: informer}, nil
+}
+
+// Create persists job as a new EvaluationJob custom resource.
+func (c *EvaluationJobClient) Create(ctx context.Context, job *evalhubv1alpha1.EvaluationJob) (*evalhubv1alpha1.EvaluationJob, error) {
+	obj, err := evaluationJobToUnstructured(job)
+	if err != nil {
+		return nil, fmt.Errorf("converting evaluation job %s: %w", job.Name, err)
+	}
+
+	created, err := c.helper.CreateObject(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("creating evaluation job %s: %w", job.Name, err)
+	}
+	return evaluationJobFromUnstructured(created)
+}
+
+// List returns every EvaluationJob currently in the informer's cache.
+func (c *EvaluationJobClient) List() ([]*evalhubv1alpha1.EvaluationJob, error) {
+	items := c.informer.GetStore().List()
+	jobs := make([]*evalhubv1alpha1.EvaluationJob, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		job, err := evaluationJobFromUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// Get returns the EvaluationJob named name in namespace from the informer's cache, or nil
+// if it isn't present.
+func (c *EvaluationJobClient) Get(namespace, name string) (*evalhubv1alpha1.EvaluationJob, error) {
+	item, exists, err := c.informer.GetStore().GetByKey(namespace + "/" + name)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return evaluationJobFromUnstructured(item.(*unstructured.Unstructured))
+}
+
+func evaluationJobToUnstructured(job *evalhubv1alpha1.EvaluationJob) (*unstructured.Unstructured, error) {
+	job.TypeMeta = metav1.TypeMeta{APIVersion: evalhubv1alpha1.SchemeGroupVersion.String(), Kind: "EvaluationJob"}
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(job)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: data}, nil
+}
+
+func evaluationJobFromUnstructured(obj *unstructured.Unstructured) (*evalhubv1alpha1.EvaluationJob, error) {
+	job := &evalhubv1alpha1.EvaluationJob{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
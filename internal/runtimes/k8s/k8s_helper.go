@@ -3,21 +3,29 @@ package k8s
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"text/template"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -137,20 +145,143 @@ func (h *KubernetesHelper) CreateResourceFromFile(
 		return nil, err
 	}
 
+	return h.CreateObject(ctx, obj)
+}
+
+// CreateObject creates a single unstructured resource, resolving its REST mapping via the
+// cached discovery RESTMapper. This is the shared create path used by CreateResourceFromFile
+// and by renderers (e.g. HelmRenderer) that produce objects from other sources.
+func (h *KubernetesHelper) CreateObject(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	gvk := obj.GroupVersionKind()
 	mapping, err := h.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 	if err != nil {
 		return nil, err
 	}
 
-	var result *unstructured.Unstructured
 	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
-		result, err = h.dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{})
+		return h.dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{})
+	}
+	return h.dynamicClient.Resource(mapping.Resource).Create(ctx, obj, metav1.CreateOptions{})
+}
+
+// DeleteResource deletes the resource identified by gvk/namespace/name, honoring the
+// given deletion propagation policy (e.g. metav1.DeletePropagationForeground so that
+// dependents such as a Job's Pods are deleted before the owner itself is removed). The call
+// returns once the API server has accepted the deletion; it does not wait for dependents.
+func (h *KubernetesHelper) DeleteResource(
+	ctx context.Context,
+	gvk schema.GroupVersionKind,
+	namespace, name string,
+	propagation metav1.DeletionPropagation,
+) error {
+	mapping, err := h.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	opts := metav1.DeleteOptions{PropagationPolicy: &propagation}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return h.dynamicClient.Resource(mapping.Resource).Namespace(namespace).Delete(ctx, name, opts)
+	}
+	return h.dynamicClient.Resource(mapping.Resource).Delete(ctx, name, opts)
+}
+
+// GetObject fetches the resource identified by gvk/namespace/name, returning nil with no
+// error if it does not exist.
+func (h *KubernetesHelper) GetObject(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	mapping, err := h.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj *unstructured.Unstructured
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj, err = h.dynamicClient.Resource(mapping.Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
 	} else {
-		result, err = h.dynamicClient.Resource(mapping.Resource).Create(ctx, obj, metav1.CreateOptions{})
+		obj, err = h.dynamicClient.Resource(mapping.Resource).Get(ctx, name, metav1.GetOptions{})
+	}
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return obj, err
+}
+
+// PatchObject applies data to the named resource using patchType, returning the patched object.
+func (h *KubernetesHelper) PatchObject(
+	ctx context.Context,
+	gvk schema.GroupVersionKind,
+	namespace, name string,
+	patchType types.PatchType,
+	data []byte,
+) (*unstructured.Unstructured, error) {
+	mapping, err := h.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return h.dynamicClient.Resource(mapping.Resource).Namespace(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+	}
+	return h.dynamicClient.Resource(mapping.Resource).Patch(ctx, name, patchType, data, metav1.PatchOptions{})
+}
+
+// ReplaceObject replaces obj wholesale via Update, for callers that want PUT semantics
+// instead of a patch.
+func (h *KubernetesHelper) ReplaceObject(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := h.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return h.dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
 	}
+	return h.dynamicClient.Resource(mapping.Resource).Update(ctx, obj, metav1.UpdateOptions{})
+}
+
+// InformerFor returns a SharedIndexInformer for gvr, scoped to namespace (empty string
+// watches all namespaces) and relisting at most every resyncPeriod. The caller is
+// responsible for running it (informer.Run(stopCh)) and waiting for its initial sync.
+func (h *KubernetesHelper) InformerFor(gvr schema.GroupVersionResource, namespace string, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(h.dynamicClient, resyncPeriod, namespace, nil)
+	return factory.ForResource(gvr).Informer()
+}
+
+// PodsForSelector lists the pods in namespace matching labelSelector, used to track the
+// Pods owned by a Job while it runs.
+func (h *KubernetesHelper) PodsForSelector(ctx context.Context, namespace, labelSelector string) ([]corev1.Pod, error) {
+	list, err := h.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return list.Items, nil
+}
+
+// PodLogs opens a log stream for a single container of a pod. Callers are responsible for
+// closing the returned stream. Set follow to true to keep the stream open as new lines arrive.
+func (h *KubernetesHelper) PodLogs(ctx context.Context, namespace, pod, container string, follow bool) (io.ReadCloser, error) {
+	opts := &corev1.PodLogOptions{Container: container, Follow: follow}
+	return h.clientset.CoreV1().Pods(namespace).GetLogs(pod, opts).Stream(ctx)
+}
+
+// CancelJob deletes the batchv1.Job for jobID in namespace with foreground propagation, so
+// its pods are deleted before the Job itself is removed; the call does not wait for that.
+// Both K8sRuntime.CancelEvaluationJob and HandleCancelEvaluation call this so there is a
+// single place that stops work running on the cluster. Only the Job named JobName(jobID)
+// is deleted; other resources a chart-based evaluation installed are left in place.
+// Deletion goes through ResourceApplier.Delete, so a missing Job isn't treated as an error.
+func (h *KubernetesHelper) CancelJob(ctx context.Context, namespace, jobID string) error {
+	if namespace == "" {
+		namespace = "default"
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(JobGVK)
+	obj.SetNamespace(namespace)
+	obj.SetName(JobName(jobID))
+
+	if err := NewResourceApplier(h).Delete(ctx, []*unstructured.Unstructured{obj}, metav1.DeletePropagationForeground); err != nil {
+		return fmt.Errorf("deleting evaluation job %s: %w", jobID, err)
+	}
+	return nil
 }
@@ -1,12 +1,36 @@
 package k8s
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/eval-hub/eval-hub/internal/abstractions"
+	"github.com/eval-hub/eval-hub/internal/runtimes/k8s/healthcheck"
 	"github.com/eval-hub/eval-hub/pkg/api"
 )
 
+// podPollInterval controls how often pod status is re-checked while a Job runs.
+const podPollInterval = 2 * time.Second
+
+// evaluationRunTimeout bounds how long a single RunEvaluationJob call is allowed to run.
+// RunEvaluationJob doesn't take a context from its caller (it implements abstractions.Runtime),
+// so without this a stuck evaluation would poll forever with nothing able to cancel it.
+const evaluationRunTimeout = 2 * time.Hour
+
+// JobGVK identifies the batch/v1 Job kind created for an evaluation.
+var JobGVK = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+
 type K8sRuntime struct {
 	logger *slog.Logger
 	helper *KubernetesHelper
@@ -18,10 +42,247 @@ func NewK8sRuntime(logger *slog.Logger, helper *KubernetesHelper) (abstractions.
 	return &K8sRuntime{logger: logger, helper: helper}, nil
 }
 
+// RunEvaluationJob materializes evaluation as a batchv1.Job, waits for it to reach a
+// terminal pod phase, and persists its container logs to storage before returning.
+// The evaluation's Status is updated in place to reflect the outcome.
+//
+// The Job is built as a typed batchv1.Job and handed to the cluster as an unstructured
+// object, rather than interpolated into a YAML manifest string: ProviderID/BenchmarkID/Args
+// all come from the evaluation request, and substituting them into quoted YAML scalars via
+// text/template would let a crafted value (e.g. one containing a closing quote and a
+// trailing YAML key) alter the rendered document instead of just the string.
 func (r *K8sRuntime) RunEvaluationJob(evaluation *api.EvaluationJobResource, storage *abstractions.Storage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), evaluationRunTimeout)
+	defer cancel()
+
+	namespace := evaluation.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	selector := JobSelector(evaluation.ID)
+
+	var objs []*unstructured.Unstructured
+
+	// Providers shipped as a Helm chart (ChartLocation set) are rendered and installed
+	// through HelmRenderer instead of the hand-built Job below, so third-party
+	// lm-eval/unitxt/trustyai charts can be used as-is.
+	if evaluation.ChartLocation != "" {
+		installed, err := r.runHelmEvaluation(ctx, namespace, evaluation)
+		if err != nil {
+			evaluation.Status = api.EvaluationStatusFailed
+			return fmt.Errorf("installing chart for evaluation job %s: %w", evaluation.ID, err)
+		}
+		objs = installed
+		r.logger.Info("installed evaluation chart", "job_id", evaluation.ID, "namespace", namespace, "chart", evaluation.ChartLocation)
+	} else {
+		obj, err := toUnstructuredObject(buildEvaluationJob(namespace, evaluation))
+		if err != nil {
+			evaluation.Status = api.EvaluationStatusFailed
+			return fmt.Errorf("converting evaluation job %s: %w", evaluation.ID, err)
+		}
+
+		// Apply rather than a bare create so re-running a reconcile for a job that already
+		// exists on the cluster (e.g. after a controller restart) patches it in place
+		// instead of failing with AlreadyExists.
+		if err := NewResourceApplier(r.helper).Apply(ctx, []*unstructured.Unstructured{obj}); err != nil {
+			evaluation.Status = api.EvaluationStatusFailed
+			return fmt.Errorf("creating evaluation job %s: %w", evaluation.ID, err)
+		}
+		objs = []*unstructured.Unstructured{obj}
+		r.logger.Info("created evaluation job", "job_id", evaluation.ID, "namespace", namespace, "resource", JobName(evaluation.ID))
+	}
+
+	// Run any test hooks (Helm's test-hook convention, but Pods/Jobs annotated eval-hub.io/hook=test)
+	// among the resources just created before treating the workload as running. A
+	// non-chart Job never carries this annotation, so this is a no-op outside the chart path.
+	if hooks := healthcheck.Discover(objs); len(hooks) > 0 {
+		results, hcErr := healthcheck.NewRunner(r.helper, 0).Run(ctx, namespace, hooks, storage)
+		evaluation.Healthchecks = results
+		if hcErr != nil {
+			evaluation.Status = api.EvaluationStatusFailed
+			return fmt.Errorf("running healthchecks for evaluation job %s: %w", evaluation.ID, hcErr)
+		}
+	}
+
+	status, waitErr := r.waitForJob(ctx, namespace, selector)
+	if logErr := r.captureLogs(ctx, namespace, selector, storage); logErr != nil {
+		r.logger.Warn("failed to capture evaluation job logs", "job_id", evaluation.ID, "error", logErr)
+	}
+	if waitErr != nil {
+		evaluation.Status = api.EvaluationStatusFailed
+		return fmt.Errorf("running evaluation job %s: %w", evaluation.ID, waitErr)
+	}
+
+	evaluation.Status = status
 	return nil
 }
 
+// CancelEvaluationJob deletes the Job backing evaluation jobID with foreground propagation
+// so its pods are deleted before the Job itself is removed. It delegates to
+// KubernetesHelper.CancelJob, which HandleCancelEvaluation also calls directly.
+func (r *K8sRuntime) CancelEvaluationJob(jobID, namespace string) error {
+	return r.helper.CancelJob(context.Background(), namespace, jobID)
+}
+
+// runHelmEvaluation installs evaluation.ChartLocation as the evaluation's workload, running
+// any pre/post-install hooks the chart declares in Helm's own hook-weight order.
+func (r *K8sRuntime) runHelmEvaluation(ctx context.Context, namespace string, evaluation *api.EvaluationJobResource) ([]*unstructured.Unstructured, error) {
+	renderer := NewHelmRenderer(r.helper)
+	source := ChartSource{Location: evaluation.ChartLocation, Version: evaluation.ChartVersion}
+
+	values := map[string]interface{}{
+		"jobId":       evaluation.ID,
+		"providerId":  evaluation.ProviderID,
+		"benchmarkId": evaluation.BenchmarkID,
+		"image":       evaluation.Image,
+	}
+	for k, v := range evaluation.ChartValues {
+		values[k] = v
+	}
+
+	return renderer.InstallWithHooks(ctx, namespace, JobName(evaluation.ID), source, values)
+}
+
+// waitForJob polls the evaluation's pods until the first pod listed reaches a terminal
+// phase, or ctx is cancelled; the phases of any other pods are not checked. The caller's context controls the overall timeout for this evaluation.
+func (r *K8sRuntime) waitForJob(ctx context.Context, namespace, selector string) (api.EvaluationStatus, error) {
+	ticker := time.NewTicker(podPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return api.EvaluationStatusFailed, ctx.Err()
+		case <-ticker.C:
+			pods, err := r.helper.PodsForSelector(ctx, namespace, selector)
+			if err != nil {
+				return api.EvaluationStatusFailed, err
+			}
+			if len(pods) == 0 {
+				continue
+			}
+			switch pods[0].Status.Phase {
+			case corev1.PodSucceeded:
+				return api.EvaluationStatusSucceeded, nil
+			case corev1.PodFailed:
+				return api.EvaluationStatusFailed, fmt.Errorf("pod %s failed", pods[0].Name)
+			}
+		}
+	}
+}
+
+// captureLogs reads every container's logs for the evaluation's pods and writes them to
+// storage, keyed by pod and container name.
+func (r *K8sRuntime) captureLogs(ctx context.Context, namespace, selector string, storage *abstractions.Storage) error {
+	pods, err := r.helper.PodsForSelector(ctx, namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			if err := r.captureContainerLogs(ctx, namespace, pod.Name, container.Name, storage); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *K8sRuntime) captureContainerLogs(ctx context.Context, namespace, pod, container string, storage *abstractions.Storage) error {
+	stream, err := r.helper.PodLogs(ctx, namespace, pod, container, false)
+	if err != nil {
+		return fmt.Errorf("opening logs for %s/%s: %w", pod, container, err)
+	}
+	defer stream.Close()
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return fmt.Errorf("reading logs for %s/%s: %w", pod, container, err)
+	}
+
+	if err := storage.Write(ctx, fmt.Sprintf("%s/%s.log", pod, container), data); err != nil {
+		return fmt.Errorf("persisting logs for %s/%s: %w", pod, container, err)
+	}
+	return nil
+}
+
+// buildEvaluationJob constructs the batchv1.Job for evaluation as a typed Go value, so its
+// fields are populated via the Kubernetes API machinery rather than string interpolation.
+func buildEvaluationJob(namespace string, evaluation *api.EvaluationJobResource) *batchv1.Job {
+	backoffLimit := int32(0)
+	jobLabels := map[string]string{
+		"eval-hub.io/job-id":       evaluation.ID,
+		"eval-hub.io/provider-id":  evaluation.ProviderID,
+		"eval-hub.io/benchmark-id": evaluation.BenchmarkID,
+	}
+
+	var envFrom []corev1.EnvFromSource
+	if evaluation.StorageSecretRef != "" {
+		envFrom = []corev1.EnvFromSource{
+			{SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: evaluation.StorageSecretRef},
+			}},
+		}
+	}
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      JobName(evaluation.ID),
+			Namespace: namespace,
+			Labels:    jobLabels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"eval-hub.io/job-id":      evaluation.ID,
+						"eval-hub.io/provider-id": evaluation.ProviderID,
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:  "evaluator",
+							Image: evaluation.Image,
+							Env: []corev1.EnvVar{
+								{Name: "EVAL_HUB_PROVIDER_ID", Value: evaluation.ProviderID},
+								{Name: "EVAL_HUB_BENCHMARK_ID", Value: evaluation.BenchmarkID},
+								{Name: "EVAL_HUB_JOB_ARGS", Value: strings.Join(evaluation.Args, " ")},
+							},
+							EnvFrom: envFrom,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// toUnstructuredObject converts a typed Kubernetes API object into the *unstructured.Unstructured
+// form KubernetesHelper's create/apply paths operate on.
+func toUnstructuredObject(obj interface{}) (*unstructured.Unstructured, error) {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: data}, nil
+}
+
+// JobName returns the batchv1.Job name used for the evaluation with the given ID.
+func JobName(jobID string) string {
+	return fmt.Sprintf("eval-%s", jobID)
+}
+
+// JobSelector returns the label selector that matches the pods of the evaluation with
+// the given ID.
+func JobSelector(jobID string) string {
+	return fmt.Sprintf("eval-hub.io/job-id=%s", jobID)
+}
+
 func (r *K8sRuntime) Name() string {
 	return "kubernetes"
 }
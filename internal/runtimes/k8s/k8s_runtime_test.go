@@ -0,0 +1,67 @@
+package k8s
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eval-hub/eval-hub/pkg/api"
+)
+
+func TestBuildEvaluationJobEnvFrom(t *testing.T) {
+	t.Run("with storage secret ref", func(t *testing.T) {
+		job := buildEvaluationJob("default", &api.EvaluationJobResource{
+			ID:               "job-1",
+			ProviderID:       "provider-1",
+			BenchmarkID:      "benchmark-1",
+			Image:            "eval-hub/evaluator:latest",
+			Args:             []string{"--foo", "bar"},
+			StorageSecretRef: "eval-storage",
+		})
+
+		envFrom := job.Spec.Template.Spec.Containers[0].EnvFrom
+		if len(envFrom) != 1 || envFrom[0].SecretRef == nil || envFrom[0].SecretRef.Name != "eval-storage" {
+			t.Fatalf("expected a single secretRef envFrom entry named eval-storage, got %+v", envFrom)
+		}
+	})
+
+	t.Run("without storage secret ref", func(t *testing.T) {
+		job := buildEvaluationJob("default", &api.EvaluationJobResource{
+			ID:          "job-2",
+			ProviderID:  "provider-1",
+			BenchmarkID: "benchmark-1",
+			Image:       "eval-hub/evaluator:latest",
+		})
+
+		envFrom := job.Spec.Template.Spec.Containers[0].EnvFrom
+		if len(envFrom) != 0 {
+			t.Fatalf("expected no envFrom entries when StorageSecretRef is unset, got %+v", envFrom)
+		}
+	})
+}
+
+func TestBuildEvaluationJobArgsJoined(t *testing.T) {
+	job := buildEvaluationJob("default", &api.EvaluationJobResource{
+		ID:   "job-3",
+		Args: []string{"--a", "1", "--b", "2"},
+	})
+
+	container := job.Spec.Template.Spec.Containers[0]
+	for _, env := range container.Env {
+		if env.Name == "EVAL_HUB_JOB_ARGS" {
+			if env.Value != strings.Join([]string{"--a", "1", "--b", "2"}, " ") {
+				t.Fatalf("unexpected EVAL_HUB_JOB_ARGS value: %q", env.Value)
+			}
+			return
+		}
+	}
+	t.Fatal("EVAL_HUB_JOB_ARGS env var not set")
+}
+
+func TestJobNameAndSelector(t *testing.T) {
+	if got, want := JobName("abc"), "eval-abc"; got != want {
+		t.Errorf("JobName() = %q, want %q", got, want)
+	}
+	if got, want := JobSelector("abc"), "eval-hub.io/job-id=abc"; got != want {
+		t.Errorf("JobSelector() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,187 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// LogRecord is a single multiplexed log line from one container of one evaluation pod.
+type LogRecord struct {
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	Timestamp time.Time `json:"ts"`
+	Line      string    `json:"line"`
+}
+
+// StreamJobLogs multiplexes the log output of every container across every pod matching
+// jobID onto records, following new lines as they arrive until ctx is cancelled or every
+// container's stream ends on its own. When sinceTime is non-zero, only lines logged at or
+// after it are delivered, so a client can resume a dropped connection without replaying
+// everything. Callers own records and should range over it, and must not close it until
+// after this call returns: it always waits for every per-container goroutine to finish
+// before returning, so none of them can still be sending once it does.
+func (h *KubernetesHelper) StreamJobLogs(ctx context.Context, namespace, jobID string, sinceTime time.Time, records chan<- LogRecord) error {
+	pods, err := h.PodsForSelector(ctx, namespace, JobSelector(jobID))
+	if err != nil {
+		return err
+	}
+
+	// streamCtx is cancelled on the first container error (in addition to ctx itself being
+	// cancelled), so that one failing stream doesn't leave its siblings running indefinitely.
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 1)
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			wg.Add(1)
+			go func(podName, containerName string) {
+				defer wg.Done()
+				if err := h.streamContainerLogs(streamCtx, namespace, podName, containerName, sinceTime, records); err != nil {
+					select {
+					case errCh <- err:
+						cancel()
+					default:
+					}
+				}
+			}(pod.Name, container.Name)
+		}
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+func (h *KubernetesHelper) streamContainerLogs(ctx context.Context, namespace, pod, container string, sinceTime time.Time, records chan<- LogRecord) error {
+	opts := &corev1.PodLogOptions{Container: container, Follow: true, Timestamps: true}
+	if !sinceTime.IsZero() {
+		t := metav1.NewTime(sinceTime)
+		opts.SinceTime = &t
+	}
+
+	stream, err := h.clientset.CoreV1().Pods(namespace).GetLogs(pod, opts).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("opening logs for %s/%s: %w", pod, container, err)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		ts, line := splitTimestampedLine(scanner.Text())
+		select {
+		case records <- LogRecord{Pod: pod, Container: container, Timestamp: ts, Line: line}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return scanner.Err()
+}
+
+// splitTimestampedLine splits a line produced with PodLogOptions.Timestamps=true
+// ("<RFC3339Nano timestamp> <line>") back into its two parts.
+func splitTimestampedLine(raw string) (time.Time, string) {
+	parts := strings.SplitN(raw, " ", 2)
+	if len(parts) != 2 {
+		return time.Time{}, raw
+	}
+	ts, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, raw
+	}
+	return ts, parts[1]
+}
+
+// EventRecord is a single Kubernetes event involving one of an evaluation's resources.
+type EventRecord struct {
+	Resource string    `json:"resource"`
+	Reason   string    `json:"reason"`
+	Message  string    `json:"message"`
+	Type     string    `json:"type"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// WatchJobEvents streams corev1.Event objects whose InvolvedObject.UID is in resourceUIDs
+// onto records until ctx is cancelled or the API server closes the watch, in which case it
+// returns nil; a closed watch is not re-opened. When sinceTime is non-zero, events last seen before
+// it are skipped, so a client can resume a dropped connection without replaying everything:
+// a List of events already on the server is replayed first (a bare Watch starts from "now"
+// and would otherwise silently drop anything that happened during the disconnect), before
+// switching to an ongoing Watch from the List's ResourceVersion. Callers own records and
+// should range over it until this call returns.
+func (h *KubernetesHelper) WatchJobEvents(ctx context.Context, namespace string, resourceUIDs map[types.UID]bool, sinceTime time.Time, records chan<- EventRecord) error {
+	events := h.clientset.CoreV1().Events(namespace)
+
+	list, err := events.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing events in %s: %w", namespace, err)
+	}
+	for _, event := range list.Items {
+		if !emitEvent(event, resourceUIDs, sinceTime, records, ctx) {
+			return ctx.Err()
+		}
+	}
+
+	watcher, err := events.Watch(ctx, metav1.ListOptions{ResourceVersion: list.ResourceVersion})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			event, ok := evt.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+			if !emitEvent(*event, resourceUIDs, sinceTime, records, ctx) {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// emitEvent sends event onto records as an EventRecord if it involves one of resourceUIDs
+// and was last seen at or after sinceTime, blocking until it's delivered or ctx is
+// cancelled. It reports whether it should keep going: false means ctx was cancelled and
+// the caller should stop.
+func emitEvent(event corev1.Event, resourceUIDs map[types.UID]bool, sinceTime time.Time, records chan<- EventRecord, ctx context.Context) bool {
+	if !resourceUIDs[event.InvolvedObject.UID] {
+		return true
+	}
+	if !sinceTime.IsZero() && event.LastTimestamp.Time.Before(sinceTime) {
+		return true
+	}
+	select {
+	case records <- EventRecord{
+		Resource: event.InvolvedObject.Name,
+		Reason:   event.Reason,
+		Message:  event.Message,
+		Type:     event.Type,
+		LastSeen: event.LastTimestamp.Time,
+	}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,104 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSplitTimestampedLine(t *testing.T) {
+	ts, line := splitTimestampedLine("2024-01-02T03:04:05.123456789Z hello world")
+	if line != "hello world" {
+		t.Fatalf("line = %q, want %q", line, "hello world")
+	}
+	want := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	if !ts.Equal(want) {
+		t.Fatalf("ts = %v, want %v", ts, want)
+	}
+}
+
+func TestSplitTimestampedLineMalformed(t *testing.T) {
+	ts, line := splitTimestampedLine("not-a-timestamp")
+	if !ts.IsZero() || line != "not-a-timestamp" {
+		t.Fatalf("splitTimestampedLine(malformed) = (%v, %q), want zero time and original line", ts, line)
+	}
+}
+
+func TestEmitEventFiltersByUID(t *testing.T) {
+	records := make(chan EventRecord, 1)
+	ok := emitEvent(corev1.Event{
+		InvolvedObject: corev1.ObjectReference{UID: types.UID("other")},
+	}, map[types.UID]bool{"mine": true}, time.Time{}, records, context.Background())
+
+	if !ok {
+		t.Fatal("emitEvent should keep going on an uninteresting event")
+	}
+	select {
+	case rec := <-records:
+		t.Fatalf("expected no record for an uninvolved UID, got %+v", rec)
+	default:
+	}
+}
+
+func TestEmitEventFiltersBySinceTime(t *testing.T) {
+	records := make(chan EventRecord, 1)
+	sinceTime := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	event := corev1.Event{
+		InvolvedObject: corev1.ObjectReference{UID: types.UID("mine")},
+		LastTimestamp:  metav1.NewTime(sinceTime.Add(-time.Hour)),
+	}
+
+	ok := emitEvent(event, map[types.UID]bool{"mine": true}, sinceTime, records, context.Background())
+	if !ok {
+		t.Fatal("emitEvent should keep going on a stale event")
+	}
+	select {
+	case rec := <-records:
+		t.Fatalf("expected no record for an event before sinceTime, got %+v", rec)
+	default:
+	}
+}
+
+func TestEmitEventDelivers(t *testing.T) {
+	records := make(chan EventRecord, 1)
+	lastSeen := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	event := corev1.Event{
+		InvolvedObject: corev1.ObjectReference{UID: types.UID("mine"), Name: "eval-job"},
+		Reason:         "BackOff",
+		Message:        "image pull failed",
+		Type:           "Warning",
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+
+	ok := emitEvent(event, map[types.UID]bool{"mine": true}, time.Time{}, records, context.Background())
+	if !ok {
+		t.Fatal("emitEvent should report success")
+	}
+
+	select {
+	case rec := <-records:
+		if rec.Resource != "eval-job" || rec.Reason != "BackOff" || !rec.LastSeen.Equal(lastSeen) {
+			t.Fatalf("unexpected record: %+v", rec)
+		}
+	default:
+		t.Fatal("expected a record to be delivered")
+	}
+}
+
+func TestEmitEventStopsOnCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// An unbuffered channel with no reader: emitEvent must give up via ctx.Done() instead
+	// of blocking forever on the send.
+	records := make(chan EventRecord)
+	event := corev1.Event{InvolvedObject: corev1.ObjectReference{UID: types.UID("mine")}}
+
+	if ok := emitEvent(event, map[types.UID]bool{"mine": true}, time.Time{}, records, ctx); ok {
+		t.Fatal("emitEvent should report false when ctx is already cancelled")
+	}
+}
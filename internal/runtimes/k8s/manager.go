@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/eval-hub/eval-hub/internal/abstractions"
+	evalhubv1alpha1 "github.com/eval-hub/eval-hub/pkg/apis/evalhub/v1alpha1"
+)
+
+// controllerScheme registers both the built-in Kubernetes types and the EvaluationJob CRD
+// types the controller needs to decode.
+var controllerScheme = func() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = evalhubv1alpha1.AddToScheme(s)
+	return s
+}()
+
+// StartController builds a controller-runtime manager for the EvaluationJob CRD and runs
+// its reconcile loop in the background until ctx is cancelled. storage is forwarded to
+// RunEvaluationJob for each job the controller reconciles.
+func (r *K8sRuntime) StartController(ctx context.Context, storage *abstractions.Storage) error {
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{Scheme: controllerScheme})
+	if err != nil {
+		return err
+	}
+	if err := SetupEvaluationJobController(mgr, r, storage); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			r.logger.Error("evaluation job controller stopped", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Bootstrap wires up everything Kubernetes mode needs: a KubernetesHelper-backed runtime, the
+// EvaluationJobReconciler controller (reconciling EvaluationJob CRs into the hand-built Jobs
+// RunEvaluationJob creates; the CR spec carries no chart location, so no Helm release is
+// installed on this path), and an EvaluationJobClient for the HTTP handlers to read and write
+// those same CRs through. Call this once during server startup when running against a real
+// cluster (i.e. LocalMode is false), and assign the returned runtime to the server's
+// abstractions.Runtime and the jobs client to Handlers.jobs. Bootstrap does not return the
+// KubernetesHelper whose methods (CancelJob, StreamJobLogs, ...) Handlers.kube is called with.
+func Bootstrap(ctx context.Context, logger *slog.Logger, storage *abstractions.Storage, namespace string) (*K8sRuntime, *EvaluationJobClient, error) {
+	helper, err := NewKubernetesHelper()
+	if err != nil {
+		return nil, nil, fmt.Errorf("building kubernetes client: %w", err)
+	}
+
+	runtime, err := NewK8sRuntime(logger, helper)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building kubernetes runtime: %w", err)
+	}
+	k8sRuntime := runtime.(*K8sRuntime)
+
+	if err := k8sRuntime.StartController(ctx, storage); err != nil {
+		return nil, nil, fmt.Errorf("starting evaluation job controller: %w", err)
+	}
+
+	jobs, err := NewEvaluationJobClient(ctx, helper, namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building evaluation job client: %w", err)
+	}
+
+	return k8sRuntime, jobs, nil
+}
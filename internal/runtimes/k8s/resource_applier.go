@@ -0,0 +1,239 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// resourceOrder assigns each Kind a position in the dependency-aware apply order:
+// Namespaces, then CRDs, then RBAC, then ConfigMaps/Secrets, then Services, then
+// workloads, then Ingresses. Kinds not listed here (including CRD-backed custom
+// resources) are applied last, via defaultResourceOrder.
+var resourceOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+	"ClusterRole":              2,
+	"ClusterRoleBinding":       2,
+	"Role":                     2,
+	"RoleBinding":              2,
+	"ServiceAccount":           2,
+	"ConfigMap":                3,
+	"Secret":                   3,
+	"Service":                  4,
+	"Deployment":               5,
+	"StatefulSet":              5,
+	"DaemonSet":                5,
+	"Job":                      5,
+	"CronJob":                  5,
+	"Ingress":                  6,
+}
+
+const defaultResourceOrder = 99
+
+const (
+	crdEstablishedTimeout      = 60 * time.Second
+	crdEstablishedPollInterval = time.Second
+)
+
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// ResourceApplier installs a set of manifests in dependency order, waiting for CRDs to
+// become Established before applying any custom resource that might depend on them. It
+// mirrors the create/apply/patch/delete/replace split used by cli-runtime-based clients,
+// so evaluation jobs can be re-applied idempotently and torn down cleanly.
+type ResourceApplier struct {
+	helper *KubernetesHelper
+}
+
+// NewResourceApplier builds a ResourceApplier backed by helper.
+func NewResourceApplier(helper *KubernetesHelper) *ResourceApplier {
+	return &ResourceApplier{helper: helper}
+}
+
+// LoadManifestDir reads every *.yaml/*.yml file under dir and returns their parsed
+// objects. Apply/Replace/Delete reorder the result themselves, so callers don't need to
+// sort these by hand.
+func LoadManifestDir(dir string) ([]*unstructured.Unstructured, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []*unstructured.Unstructured
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml")) {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		docs, err := splitManifests(string(raw))
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+		objs = append(objs, docs...)
+	}
+	return objs, nil
+}
+
+// Apply installs objs in dependency order. Each object is created if it doesn't already
+// exist, or else the whole object is sent as a strategic merge patch, falling back to a
+// JSON merge patch whenever that fails (as it does for custom resources, which have no
+// strategic-merge schema). This is not a three-way merge: fields dropped from obj since an
+// earlier Apply are left on the live object. Once every CRD in
+// objs has been applied, Apply waits for them to become Established before continuing, so
+// later-ordered custom resources that depend on them don't fail with "no matches for kind".
+func (a *ResourceApplier) Apply(ctx context.Context, objs []*unstructured.Unstructured) error {
+	sorted := sortedByDependency(objs, false)
+
+	var pendingCRDs []*unstructured.Unstructured
+	for i, obj := range sorted {
+		if err := a.applyOne(ctx, obj); err != nil {
+			return fmt.Errorf("applying %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+		if obj.GetKind() == "CustomResourceDefinition" {
+			pendingCRDs = append(pendingCRDs, obj)
+		}
+
+		atCRDBoundary := i+1 == len(sorted) || orderOf(sorted[i+1].GetKind()) > resourceOrder["CustomResourceDefinition"]
+		if len(pendingCRDs) > 0 && atCRDBoundary {
+			for _, crd := range pendingCRDs {
+				if err := a.waitForCRDEstablished(ctx, crd.GetName()); err != nil {
+					return err
+				}
+			}
+			pendingCRDs = nil
+		}
+	}
+	return nil
+}
+
+func (a *ResourceApplier) applyOne(ctx context.Context, obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	existing, err := a.helper.GetObject(ctx, gvk, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		_, err := a.helper.CreateObject(ctx, obj)
+		return err
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.helper.PatchObject(ctx, gvk, obj.GetNamespace(), obj.GetName(), types.StrategicMergePatchType, data)
+	if err != nil {
+		// Custom resources have no strategic-merge schema; fall back to a JSON merge patch.
+		_, err = a.helper.PatchObject(ctx, gvk, obj.GetNamespace(), obj.GetName(), types.MergePatchType, data)
+	}
+	return err
+}
+
+// Replace replaces each object wholesale (PUT semantics), carrying over resourceVersion
+// from the existing object when present so the update doesn't spuriously conflict.
+func (a *ResourceApplier) Replace(ctx context.Context, objs []*unstructured.Unstructured) error {
+	for _, obj := range sortedByDependency(objs, false) {
+		existing, err := a.helper.GetObject(ctx, obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+		if err != nil {
+			return fmt.Errorf("looking up %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+		if existing != nil {
+			obj.SetResourceVersion(existing.GetResourceVersion())
+		}
+		if _, err := a.helper.ReplaceObject(ctx, obj); err != nil {
+			return fmt.Errorf("replacing %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Delete removes objs in reverse dependency order (e.g. workloads before the namespace
+// they live in), honoring the given propagation policy. Objects that are already gone are
+// not treated as an error.
+func (a *ResourceApplier) Delete(ctx context.Context, objs []*unstructured.Unstructured, propagation metav1.DeletionPropagation) error {
+	for _, obj := range sortedByDependency(objs, true) {
+		err := a.helper.DeleteResource(ctx, obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName(), propagation)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (a *ResourceApplier) waitForCRDEstablished(ctx context.Context, name string) error {
+	deadline := time.Now().Add(crdEstablishedTimeout)
+	for {
+		obj, err := a.helper.GetObject(ctx, crdGVK, "", name)
+		if err != nil {
+			return fmt.Errorf("checking CRD %s: %w", name, err)
+		}
+		if obj != nil && crdEstablished(obj) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for CRD %s to become Established", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(crdEstablishedPollInterval):
+		}
+	}
+}
+
+func crdEstablished(obj *unstructured.Unstructured) bool {
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return false
+	}
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Established" && condition["status"] == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedByDependency returns a copy of objs ordered by resourceOrder, reversed when
+// reverse is true (for tear-down, where dependents must go before what they depend on).
+func sortedByDependency(objs []*unstructured.Unstructured, reverse bool) []*unstructured.Unstructured {
+	sorted := make([]*unstructured.Unstructured, len(objs))
+	copy(sorted, objs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if reverse {
+			return orderOf(sorted[i].GetKind()) > orderOf(sorted[j].GetKind())
+		}
+		return orderOf(sorted[i].GetKind()) < orderOf(sorted[j].GetKind())
+	})
+	return sorted
+}
+
+func orderOf(kind string) int {
+	if order, ok := resourceOrder[kind]; ok {
+		return order
+	}
+	return defaultResourceOrder
+}
@@ -0,0 +1,99 @@
+// Package v1alpha1 contains the v1alpha1 API group for the eval-hub.opendatahub.io
+// EvaluationJob custom resource. Promoting evaluations to a CRD makes them observable to
+// kubectl, GitOps tools, and cluster policy engines, instead of living only in the API
+// server's in-memory Storage.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GroupName is the API group for EvaluationJob and future types in this package.
+const GroupName = "eval-hub.opendatahub.io"
+
+// SchemeGroupVersion is the v1alpha1 GroupVersion for this package's types.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+// SchemeBuilder and AddToScheme let callers register this package's types with a
+// runtime.Scheme, following the standard generated-client convention.
+var (
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme   = SchemeBuilder.AddToScheme
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&EvaluationJob{},
+		&EvaluationJobList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+// EvaluationJobPhase is the high-level lifecycle phase of an EvaluationJob.
+type EvaluationJobPhase string
+
+const (
+	EvaluationJobPhasePending   EvaluationJobPhase = "Pending"
+	EvaluationJobPhaseRunning   EvaluationJobPhase = "Running"
+	EvaluationJobPhaseSucceeded EvaluationJobPhase = "Succeeded"
+	EvaluationJobPhaseFailed    EvaluationJobPhase = "Failed"
+)
+
+// EvaluationJobSpec mirrors api.EvaluationJobConfig so the CR is a faithful record of what
+// was requested through the /api/v1/evaluations/jobs API.
+type EvaluationJobSpec struct {
+	ProviderID  string                 `json:"providerId"`
+	BenchmarkID string                 `json:"benchmarkId"`
+	Config      map[string]interface{} `json:"config,omitempty"`
+
+	// Image, Args and StorageSecretRef describe the evaluator workload RunEvaluationJob
+	// materializes for this job; without them the reconciled Job would always run with an
+	// empty image.
+	Image            string   `json:"image,omitempty"`
+	Args             []string `json:"args,omitempty"`
+	StorageSecretRef string   `json:"storageSecretRef,omitempty"`
+}
+
+// HealthcheckResult records the outcome of a single post-install healthcheck hook run
+// against this EvaluationJob's resources (see internal/runtimes/k8s/healthcheck).
+type HealthcheckResult struct {
+	Name      string      `json:"name"`
+	Status    string      `json:"status"`
+	StartedAt metav1.Time `json:"startedAt,omitempty"`
+	EndedAt   metav1.Time `json:"endedAt,omitempty"`
+	LogRef    string      `json:"logRef,omitempty"`
+}
+
+// EvaluationJobStatus carries the reconciled state of an EvaluationJob, written by the
+// EvaluationJobReconciler as it drives the job towards completion.
+type EvaluationJobStatus struct {
+	Phase        EvaluationJobPhase  `json:"phase,omitempty"`
+	Conditions   []metav1.Condition  `json:"conditions,omitempty"`
+	ResultRef    string              `json:"resultRef,omitempty"`
+	Healthchecks []HealthcheckResult `json:"healthchecks,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// EvaluationJob is the schema for the evaluationjobs API.
+type EvaluationJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EvaluationJobSpec   `json:"spec,omitempty"`
+	Status EvaluationJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EvaluationJobList is a list of EvaluationJob.
+type EvaluationJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []EvaluationJob `json:"items"`
+}